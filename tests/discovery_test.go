@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsDiscoverableReadme(t *testing.T) {
+	cases := []struct {
+		path string
+		want bool
+	}{
+		{"README.md", true},
+		{"./README.md", true},
+		{"../README.md", true},
+		{"../../examples/basic/README.md", true},
+		{"examples/_private/README.md", false},
+		{"examples/.hidden/README.md", false},
+		{".hidden/README.md", false},
+	}
+
+	for _, c := range cases {
+		if got := isDiscoverableReadme(c.path); got != c.want {
+			t.Errorf("isDiscoverableReadme(%q) = %v, want %v", c.path, got, c.want)
+		}
+	}
+}
+
+func TestResolveReadme(t *testing.T) {
+	dir := t.TempDir()
+
+	withMD := filepath.Join(dir, "with-md")
+	if err := os.Mkdir(withMD, 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(withMD, "README.md"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(withMD, "README"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	withoutMD := filepath.Join(dir, "without-md")
+	if err := os.Mkdir(withoutMD, 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(withoutMD, "README"), []byte("x"), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	empty := filepath.Join(dir, "empty")
+	if err := os.Mkdir(empty, 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	resolved, ok := resolveReadme(withMD)
+	if !ok || resolved != filepath.Join(withMD, "README.md") {
+		t.Errorf("resolveReadme(%q) = (%q, %v), want README.md preferred", withMD, resolved, ok)
+	}
+
+	resolved, ok = resolveReadme(withoutMD)
+	if !ok || resolved != filepath.Join(withoutMD, "README") {
+		t.Errorf("resolveReadme(%q) = (%q, %v), want README fallback", withoutMD, resolved, ok)
+	}
+
+	if _, ok := resolveReadme(empty); ok {
+		t.Errorf("resolveReadme(%q) = ok, want not found", empty)
+	}
+
+	plainFile := filepath.Join(withMD, "README.md")
+	if resolved, ok := resolveReadme(plainFile); !ok || resolved != plainFile {
+		t.Errorf("resolveReadme(%q) = (%q, %v), want passthrough", plainFile, resolved, ok)
+	}
+}
+
+func TestGlobPatternRecursive(t *testing.T) {
+	dir := t.TempDir()
+
+	oneLevel := filepath.Join(dir, "examples", "basic")
+	twoLevels := filepath.Join(dir, "examples", "nested", "deep")
+	if err := os.MkdirAll(oneLevel, 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := os.MkdirAll(twoLevels, 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	for _, dir := range []string{oneLevel, twoLevels} {
+		if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("x"), 0o644); err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	pattern := filepath.Join(dir, "examples", "**", "README.md")
+	matches, err := globPattern(pattern)
+	if err != nil {
+		t.Fatalf("globPattern(%q) error: %v", pattern, err)
+	}
+
+	want := map[string]bool{
+		filepath.Join(oneLevel, "README.md"):  true,
+		filepath.Join(twoLevels, "README.md"): true,
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("globPattern(%q) = %v, want matches for %v", pattern, matches, want)
+	}
+	for _, m := range matches {
+		if !want[m] {
+			t.Errorf("globPattern(%q) returned unexpected match %q", pattern, m)
+		}
+	}
+}
@@ -1,101 +1,214 @@
 package main
 
 import (
-	"encoding/json"
-	"io/ioutil"
-	"net/http"
+	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
-	"sync"
 	"testing"
 
 	"mvdan.cc/xurls/v2"
 )
 
-type ErrorResponse struct {
-	Errors []ErrorDetail `json:"errors"`
-}
+// TestMarkdown validates every README discovered via README_PATHS (or the
+// single-file README_PATH, or ./README.md as a last resort), running the
+// full suite against each as an independent subtest.
+func TestMarkdown(t *testing.T) {
+	files, err := discoverReadmePaths()
+	if err != nil {
+		t.Fatalf("Failed to discover README files: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("No README files found to validate")
+	}
+
+	for _, file := range files {
+		file := file
+		t.Run(relPath(file), func(t *testing.T) {
+			t.Setenv("README_PATH", file)
 
-type ErrorDetail struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+			t.Run("URLs", validateURLs)
+			t.Run("Headers", validateReadmeHeaders)
+			t.Run("NotEmpty", validateReadmeNotEmpty)
+			t.Run("ResourceTableHeaders", validateResourceTableHeaders)
+			t.Run("InputsTableHeaders", validateInputsTableHeaders)
+			t.Run("OutputsTableHeaders", validateOutputsTableHeaders)
+			t.Run("NoDuplicateLinks", validateNoDuplicateLinks)
+			t.Run("ListOrdering", validateListOrdering)
+			t.Run("InputsMatchHCL", validateInputsMatchHCL)
+		})
+	}
 }
 
-func TestMarkdown(t *testing.T) {
-    t.Run("URLs", validateURLs)
-    t.Run("Headers", validateReadmeHeaders)
-    t.Run("NotEmpty", validateReadmeNotEmpty)
-    t.Run("ResourceTableHeaders", validateResourceTableHeaders)
-    t.Run("InputsTableHeaders", validateInputsTableHeaders)
-    t.Run("OutputsTableHeaders", validateOutputsTableHeaders)
+// discoverReadmePaths resolves README_PATHS, a comma-separated list of
+// paths and/or glob patterns (e.g. "examples/**/README.md"), falling back
+// to the single-file README_PATH and then ./README.md. Directories are
+// resolved to the README.md/README they contain, and entries under a path
+// segment starting with "_" or "." are skipped, mirroring how the
+// Terraform Registry and pkg.go.dev discover READMEs.
+func discoverReadmePaths() ([]string, error) {
+	var patterns []string
+	if paths := os.Getenv("README_PATHS"); paths != "" {
+		for _, p := range strings.Split(paths, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				patterns = append(patterns, p)
+			}
+		}
+	} else if single := os.Getenv("README_PATH"); single != "" {
+		patterns = append(patterns, single)
+	} else {
+		patterns = append(patterns, "README.md")
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := globPattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid README_PATHS pattern %q: %w", pattern, err)
+		}
+		if matches == nil {
+			matches = []string{pattern}
+		}
+
+		for _, match := range matches {
+			if !isDiscoverableReadme(match) {
+				continue
+			}
+
+			resolved, ok := resolveReadme(match)
+			if !ok || seen[resolved] {
+				continue
+			}
+			seen[resolved] = true
+			files = append(files, resolved)
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
 }
 
-func checkRegistryURL(url string) (bool, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return false, err
+// globPattern resolves pattern via filepath.Glob, except filepath.Glob has
+// no "**" semantics, so a pattern containing "**" (e.g.
+// "examples/**/README.md") is instead matched with a recursive directory
+// walk rooted just above the first "**" segment.
+func globPattern(pattern string) ([]string, error) {
+	if !strings.Contains(pattern, "**") {
+		return filepath.Glob(pattern)
 	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return false, err
+	idx := strings.Index(pattern, "**")
+	root := filepath.Dir(pattern[:idx])
+	if root == "" {
+		root = "."
 	}
+	suffix := filepath.ToSlash(strings.TrimPrefix(pattern[idx+len("**"):], "/"))
 
-	var errorResponse ErrorResponse
-	err = json.Unmarshal(body, &errorResponse)
+	var matches []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel == suffix || strings.HasSuffix(rel, "/"+suffix) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
 	if err != nil {
-		return false, err
+		return nil, err
 	}
+	return matches, nil
+}
 
-	for _, errorDetail := range errorResponse.Errors {
-		if errorDetail.Code == "NAME_UNKNOWN" {
-			return false, nil
+// resolveReadme returns path unchanged unless it names a directory, in
+// which case it prefers README.md over README inside it. ok is false when
+// path is a directory containing neither.
+func resolveReadme(path string) (resolved string, ok bool) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return path, true
+	}
+
+	for _, name := range []string{"README.md", "README"} {
+		candidate := filepath.Join(path, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, true
+		}
+	}
+	return path, false
+}
+
+// isDiscoverableReadme skips paths under a directory segment starting with
+// "_" or ".", the convention used to mark private/hidden example dirs. The
+// "." and ".." segments produced by relative paths are not hidden dirs and
+// are always discoverable.
+func isDiscoverableReadme(path string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		if part == "." || part == ".." {
+			continue
+		}
+		if strings.HasPrefix(part, "_") || strings.HasPrefix(part, ".") {
+			return false
 		}
 	}
-	return true, nil
+	return true
+}
+
+// relPath renders path relative to the working directory for use as a
+// subtest name, falling back to the cleaned path if that's not possible.
+func relPath(path string) string {
+	cleaned := filepath.Clean(path)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return cleaned
+	}
+
+	rel, err := filepath.Rel(wd, cleaned)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return cleaned
+	}
+	return rel
 }
 
 func validateURLs(t *testing.T) {
-    readmePath := os.Getenv("README_PATH")
-    data, err := os.ReadFile(readmePath)
-    if err != nil {
-        t.Fatalf("Failed to load markdown file: %v", err)
-    }
-
-    rxStrict := xurls.Strict()
-    urls := rxStrict.FindAllString(string(data), -1)
-
-    var wg sync.WaitGroup
-    for _, u := range urls {
-        wg.Add(1)
-        go func(link string) {
-            defer wg.Done()
-
-            if strings.Contains(link, "registry.terraform.io/providers/") {
-                isValid, err := checkRegistryURL(link)
-                if err != nil || !isValid {
-                    t.Errorf("Failed: Invalid registry URL: %s", link)
-                    return
-                }
-            } else {
-                resp, err := http.Get(link)
-                if err != nil {
-                    t.Errorf("Failed: URL: %s, Error: %v", link, err)
-                    return
-                }
-                defer resp.Body.Close()
-
-                if resp.StatusCode != http.StatusOK {
-                    t.Errorf("Failed: URL: %s, Status code: %d", link, resp.StatusCode)
-                } else {
-                    t.Logf("Success: URL: %s, Status code: %d", link, resp.StatusCode)
-                }
-            }
-        }(u)
-    }
-    wg.Wait()
+	readmePath := os.Getenv("README_PATH")
+	data, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatalf("Failed to load markdown file: %v", err)
+	}
+	contents := string(data)
+
+	rxStrict := xurls.Strict()
+	locs := rxStrict.FindAllStringIndex(contents, -1)
+
+	urls := make([]string, len(locs))
+	for i, loc := range locs {
+		urls[i] = contents[loc[0]:loc[1]]
+	}
+
+	checker := NewURLCheckerFromEnv()
+	for i, result := range checker.Check(urls) {
+		if result.Err != nil {
+			report(t, readmePath, contents, locs[i][0], "URL %s: %v", urls[i], result.Err)
+			continue
+		}
+		t.Logf("Success: URL: %s, Status code: %d", urls[i], result.StatusCode)
+	}
 }
 
 func validateReadmeHeaders(t *testing.T) {
@@ -122,7 +235,7 @@ func validateReadmeHeaders(t *testing.T) {
 	for header, minCount := range requiredHeaders {
 		matches := regexp.MustCompile("(?m)^"+regexp.QuoteMeta(header)).FindAllString(contents, -1)
 		if len(matches) < minCount {
-			t.Errorf("Failed: README.md does not contain required header '%s' at least %d times", header, minCount)
+			report(t, readmePath, contents, -1, "README.md does not contain required header '%s' at least %d times", header, minCount)
 		} else {
 			t.Logf("Success: README.md contains required header '%s' at least %d times", header, minCount)
 		}
@@ -140,7 +253,7 @@ func validateReadmeNotEmpty(t *testing.T) {
 	t.Log("Success: README.md file exists.")
 
 	if len(data) == 0 {
-		t.Errorf("Failed: README.md is empty.")
+		report(t, readmePath, "", -1, "README.md is empty")
 	} else {
 		t.Log("Success: README.md is not empty.")
 	}
@@ -158,42 +271,184 @@ func validateOutputsTableHeaders(t *testing.T) {
 	markdownTableHeaders(t, "Outputs", []string{"Name", "Description"})
 }
 
-func markdownTableHeaders(t *testing.T, header string, columns []string) {
+// offsetIssue is a validation failure found by pure, *testing.T-free logic,
+// located by byte offset within the README (-1 when no specific location
+// applies). Kept separate from the report-time finding type (reporter_test.go)
+// so the validate* logic can be unit-tested directly instead of through a
+// *testing.T (a failing t.Run subtest always fails its parent, even when the
+// parent never calls t.Errorf, which makes "does it fail" not unit-testable
+// via the bool that t.Run returns).
+type offsetIssue struct {
+	offset  int
+	message string
+}
+
+// findDuplicateLinks returns one offsetIssue per markdown link whose href
+// repeats an earlier link's href, wherever it appears: bullet items and
+// table cells alike (the Resources/Inputs/Outputs sections use tables).
+func findDuplicateLinks(contents string) []offsetIssue {
+	linkPattern := regexp.MustCompile(`\[([^\]]*)\]\(([^)]*)\)`)
+	matches := linkPattern.FindAllStringSubmatchIndex(contents, -1)
+
+	var issues []offsetIssue
+	seen := make(map[string]int) // href -> byte offset of first occurrence
+	for _, m := range matches {
+		href := contents[m[4]:m[5]]
+		if firstOffset, ok := seen[href]; ok {
+			firstLine, _ := lineCol(contents, firstOffset)
+			issues = append(issues, offsetIssue{offset: m[0], message: fmt.Sprintf("duplicate link %q (first seen on line %d)", href, firstLine)})
+			continue
+		}
+		seen[href] = m[0]
+	}
+	return issues
+}
+
+func validateNoDuplicateLinks(t *testing.T) {
 	readmePath := os.Getenv("README_PATH")
 	data, err := os.ReadFile(readmePath)
 	if err != nil {
 		t.Fatalf("Failed to load markdown file: %v", err)
 	}
-
 	contents := string(data)
-	requiredHeaders := []string{"## " + header}
 
-	for _, requiredHeader := range requiredHeaders {
-		headerPattern := regexp.MustCompile("(?m)^" + regexp.QuoteMeta(requiredHeader) + "\\s*$")
-		headerLoc := headerPattern.FindStringIndex(contents)
-		if headerLoc == nil {
-			t.Errorf("Failed: README.md does not contain required header")
-		} else {
-			t.Logf("Success: README.md contains required header")
+	issues := findDuplicateLinks(contents)
+	for _, issue := range issues {
+		report(t, readmePath, contents, issue.offset, "%s", issue.message)
+	}
+	t.Logf("Success: checked links for duplicates, found %d", len(issues))
+}
+
+type readmeListItem struct {
+	text   string
+	offset int
+}
+
+// findListOrderingIssues returns one offsetIssue per section (Goals-style
+// bullet lists, or Resources/Inputs/Outputs-style tables) whose items aren't
+// alphabetically ordered.
+func findListOrderingIssues(contents string) []offsetIssue {
+	headerPattern := regexp.MustCompile(`^(##|###)\s+(.+)$`)
+	linkPattern := regexp.MustCompile(`^\* \[(.*?)\]\(.*?\)`)
+
+	var currentSection string
+	sectionItems := make(map[string][]readmeListItem)
+	var sections []string
+
+	offset := 0
+	for _, line := range strings.Split(contents, "\n") {
+		if matches := headerPattern.FindStringSubmatch(line); matches != nil {
+			currentSection = matches[2]
+			if _, ok := sectionItems[currentSection]; !ok {
+				sections = append(sections, currentSection)
+			}
+		} else if matches := linkPattern.FindStringSubmatch(line); matches != nil && currentSection != "" {
+			sectionItems[currentSection] = append(sectionItems[currentSection], readmeListItem{text: matches[1], offset: offset})
 		}
+		offset += len(line) + 1
+	}
 
-		// Look for a table immediately after the header
-		tablePattern := regexp.MustCompile(`(?s)` + regexp.QuoteMeta(requiredHeader) + `(\s*\|.*\|)+\s*`)
-		tableLoc := tablePattern.FindStringIndex(contents)
-		if tableLoc == nil {
-			t.Errorf("Failed: README.md does not contain a table immediately after the header")
-		} else {
-			t.Logf("Success: README.md contains a table immediately after the header")
+	var issues []offsetIssue
+	for _, section := range sections {
+		items := sectionItems[section]
+		if len(items) == 0 {
+			// No bullet items in this section; the Resources/Inputs/Outputs
+			// sections document their entries as a table instead.
+			items = tableListItems(contents, section)
+		}
+		if len(items) < 2 {
+			continue
 		}
 
-		// Check the table headers
-		columnHeaders := strings.Join(columns, " \\| ")
-		headerRowPattern := regexp.MustCompile(`(?m)\| ` + columnHeaders + ` \|`)
-		headerRowLoc := headerRowPattern.FindStringIndex(contents[tableLoc[0]:tableLoc[1]])
-		if headerRowLoc == nil {
-			t.Errorf("Failed: README.md does not contain the correct column names in the table")
-		} else {
-			t.Logf("Success: README.md contains the correct column names in the table")
+		sorted := make([]readmeListItem, len(items))
+		copy(sorted, items)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return strings.ToLower(sorted[i].text) < strings.ToLower(sorted[j].text)
+		})
+
+		for i := range items {
+			if items[i].text != sorted[i].text {
+				issues = append(issues, offsetIssue{offset: items[i].offset, message: fmt.Sprintf("items in section %q are not alphabetically ordered: %q should come before %q", section, sorted[i].text, items[i].text)})
+				break
+			}
 		}
 	}
+	return issues
+}
+
+func validateListOrdering(t *testing.T) {
+	readmePath := os.Getenv("README_PATH")
+	data, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatalf("Failed to load markdown file: %v", err)
+	}
+	contents := string(data)
+
+	issues := findListOrderingIssues(contents)
+	for _, issue := range issues {
+		report(t, readmePath, contents, issue.offset, "%s", issue.message)
+	}
+	t.Logf("Success: checked list ordering, found %d issue(s)", len(issues))
+}
+
+var tableCellLinkPattern = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+
+// tableListItems builds ordering items from the first column of the
+// markdown table immediately following "## <section>", using the link text
+// of any markdown link found there so a table like Resources (whose Name
+// column links to the provider docs) sorts on the name, not the URL.
+func tableListItems(contents, section string) []readmeListItem {
+	rows := extractTableRows(contents, section)
+
+	items := make([]readmeListItem, 0, len(rows))
+	for _, row := range rows {
+		if len(row.cells) == 0 {
+			continue
+		}
+
+		text := row.cells[0]
+		if m := tableCellLinkPattern.FindStringSubmatch(text); m != nil {
+			text = m[1]
+		}
+		items = append(items, readmeListItem{text: text, offset: row.offset})
+	}
+	return items
+}
+
+func markdownTableHeaders(t *testing.T, header string, columns []string) {
+	readmePath := os.Getenv("README_PATH")
+	data, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatalf("Failed to load markdown file: %v", err)
+	}
+
+	contents := string(data)
+	requiredHeader := "## " + header
+
+	headerPattern := regexp.MustCompile("(?m)^" + regexp.QuoteMeta(requiredHeader) + "\\s*$")
+	headerLoc := headerPattern.FindStringIndex(contents)
+	if headerLoc == nil {
+		report(t, readmePath, contents, -1, "README.md does not contain required header %q", requiredHeader)
+		return
+	}
+	t.Logf("Success: README.md contains required header")
+
+	// Look for a table immediately after the header
+	tablePattern := regexp.MustCompile(`(?s)` + regexp.QuoteMeta(requiredHeader) + `(\s*\|.*\|)+\s*`)
+	tableLoc := tablePattern.FindStringIndex(contents)
+	if tableLoc == nil {
+		report(t, readmePath, contents, headerLoc[0], "README.md does not contain a table immediately after header %q", requiredHeader)
+		return
+	}
+	t.Logf("Success: README.md contains a table immediately after the header")
+
+	// Check the table headers
+	columnHeaders := strings.Join(columns, " \\| ")
+	headerRowPattern := regexp.MustCompile(`(?m)\| ` + columnHeaders + ` \|`)
+	headerRowLoc := headerRowPattern.FindStringIndex(contents[tableLoc[0]:tableLoc[1]])
+	if headerRowLoc == nil {
+		report(t, readmePath, contents, tableLoc[0], "README.md does not contain the correct column names in the %q table", requiredHeader)
+		return
+	}
+	t.Logf("Success: README.md contains the correct column names in the table")
 }
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// hclBlock is the subset of a variable/output block the README tables are
+// checked against.
+type hclBlock struct {
+	Name       string
+	HasDefault bool
+}
+
+// validateInputsMatchHCL cross-checks the README's Inputs and Outputs tables
+// against the module's variables.tf and outputs.tf, so the docs can't drift
+// from the code they describe. Not every discovered README (e.g. an example
+// under examples/**) has a sibling variables.tf/outputs.tf, so each side is
+// skipped independently when its file is absent rather than failing the
+// whole subtree.
+func validateInputsMatchHCL(t *testing.T) {
+	readmePath := os.Getenv("README_PATH")
+	data, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatalf("Failed to load markdown file: %v", err)
+	}
+	contents := string(data)
+	moduleDir := filepath.Dir(readmePath)
+
+	t.Run("Inputs", func(t *testing.T) {
+		variablesPath := filepath.Join(moduleDir, "variables.tf")
+		if _, err := os.Stat(variablesPath); err != nil {
+			t.Skipf("Skipping: %s not found", variablesPath)
+		}
+
+		variables, err := parseHCLBlocks(variablesPath, "variable")
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", variablesPath, err)
+		}
+		checkInputsTable(t, readmePath, contents, variables)
+	})
+
+	t.Run("Outputs", func(t *testing.T) {
+		outputsPath := filepath.Join(moduleDir, "outputs.tf")
+		if _, err := os.Stat(outputsPath); err != nil {
+			t.Skipf("Skipping: %s not found", outputsPath)
+		}
+
+		outputs, err := parseHCLBlocks(outputsPath, "output")
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", outputsPath, err)
+		}
+		checkOutputsTable(t, readmePath, contents, outputs)
+	})
+}
+
+func parseHCLBlocks(path, blockType string) (map[string]hclBlock, error) {
+	parser := hclparse.NewParser()
+	file, diags := parser.ParseHCLFile(path)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: blockType, LabelNames: []string{"name"}}},
+	})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	blocks := make(map[string]hclBlock, len(content.Blocks))
+	for _, block := range content.Blocks {
+		name := block.Labels[0]
+
+		hasDefault := false
+		if blockType == "variable" {
+			attrs, _ := block.Body.JustAttributes()
+			_, hasDefault = attrs["default"]
+		}
+
+		blocks[name] = hclBlock{Name: name, HasDefault: hasDefault}
+	}
+	return blocks, nil
+}
+
+// findInputsTableIssues is the pure logic behind checkInputsTable, kept
+// *testing.T-free so it can be unit-tested directly instead of through a
+// t.Run subtest (a failing subtest always fails its parent, even when the
+// parent never calls t.Errorf itself).
+func findInputsTableIssues(contents string, variables map[string]hclBlock) []offsetIssue {
+	rows := extractTableRows(contents, "Inputs")
+	documented := make(map[string]bool, len(rows))
+
+	var issues []offsetIssue
+	for _, row := range rows {
+		if len(row.cells) < 4 {
+			continue
+		}
+		name := row.cells[0]
+		documented[name] = true
+
+		variable, ok := variables[name]
+		if !ok {
+			issues = append(issues, offsetIssue{offset: row.offset, message: fmt.Sprintf("README documents input %q which is not declared in variables.tf", name)})
+			continue
+		}
+
+		required := strings.EqualFold(row.cells[3], "yes")
+		if required == variable.HasDefault {
+			issues = append(issues, offsetIssue{offset: row.offset, message: fmt.Sprintf("README Required column for input %q is %q but variables.tf %s a default", name, row.cells[3], defaultedVerb(variable.HasDefault))})
+		}
+	}
+
+	for name := range variables {
+		if !documented[name] {
+			issues = append(issues, offsetIssue{offset: -1, message: fmt.Sprintf("variable %q is declared in variables.tf but missing from the README Inputs table", name)})
+		}
+	}
+	return issues
+}
+
+func checkInputsTable(t *testing.T, readmePath, contents string, variables map[string]hclBlock) {
+	for _, issue := range findInputsTableIssues(contents, variables) {
+		report(t, readmePath, contents, issue.offset, "%s", issue.message)
+	}
+}
+
+// findOutputsTableIssues is the pure logic behind checkOutputsTable; see
+// findInputsTableIssues for why it's kept *testing.T-free.
+func findOutputsTableIssues(contents string, outputs map[string]hclBlock) []offsetIssue {
+	rows := extractTableRows(contents, "Outputs")
+	documented := make(map[string]bool, len(rows))
+
+	var issues []offsetIssue
+	for _, row := range rows {
+		if len(row.cells) < 1 {
+			continue
+		}
+		name := row.cells[0]
+		documented[name] = true
+
+		if _, ok := outputs[name]; !ok {
+			issues = append(issues, offsetIssue{offset: row.offset, message: fmt.Sprintf("README documents output %q which is not declared in outputs.tf", name)})
+		}
+	}
+
+	for name := range outputs {
+		if !documented[name] {
+			issues = append(issues, offsetIssue{offset: -1, message: fmt.Sprintf("output %q is declared in outputs.tf but missing from the README Outputs table", name)})
+		}
+	}
+	return issues
+}
+
+func checkOutputsTable(t *testing.T, readmePath, contents string, outputs map[string]hclBlock) {
+	for _, issue := range findOutputsTableIssues(contents, outputs) {
+		report(t, readmePath, contents, issue.offset, "%s", issue.message)
+	}
+}
+
+func defaultedVerb(hasDefault bool) string {
+	if hasDefault {
+		return "has"
+	}
+	return "has no"
+}
+
+var tableSeparatorPattern = regexp.MustCompile(`^\|[\s:|-]+$`)
+
+// tableRow is one data row of a markdown table, with its cells trimmed and
+// stripped of backticks, and offset set to the row's byte offset within the
+// README so failures can be reported with a line number.
+type tableRow struct {
+	cells  []string
+	offset int
+}
+
+// extractTableRows returns the data rows (header and separator rows
+// excluded) of the markdown table immediately following the given
+// "## <header>" section.
+func extractTableRows(contents, header string) []tableRow {
+	headerPattern := regexp.MustCompile(`(?m)^## ` + regexp.QuoteMeta(header) + `\s*$`)
+	loc := headerPattern.FindStringIndex(contents)
+	if loc == nil {
+		return nil
+	}
+
+	section := contents[loc[1]:]
+	if next := regexp.MustCompile(`(?m)^## `).FindStringIndex(section); next != nil {
+		section = section[:next[0]]
+	}
+
+	var rows []tableRow
+	offset := loc[1]
+	for _, line := range strings.Split(section, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "|") && !tableSeparatorPattern.MatchString(trimmed) {
+			cells := strings.Split(strings.Trim(trimmed, "|"), "|")
+			for i, cell := range cells {
+				cells[i] = strings.Trim(strings.TrimSpace(cell), "`")
+			}
+			rows = append(rows, tableRow{cells: cells, offset: offset})
+		}
+		offset += len(line) + 1
+	}
+
+	// The first row is the column header itself.
+	if len(rows) > 0 {
+		rows = rows[1:]
+	}
+	return rows
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtractTableRows(t *testing.T) {
+	contents := `## Inputs
+
+| Name | Description | Type | Required |
+|------|--------------|------|----------|
+| ` + "`name`" + ` | the name | string | yes |
+| ` + "`tags`" + ` | resource tags | map(string) | no |
+
+## Outputs
+`
+	rows := extractTableRows(contents, "Inputs")
+	if len(rows) != 2 {
+		t.Fatalf("extractTableRows() returned %d rows, want 2", len(rows))
+	}
+	if rows[0].cells[0] != "name" || rows[0].cells[3] != "yes" {
+		t.Errorf("extractTableRows()[0] = %+v, want name/yes", rows[0].cells)
+	}
+	if rows[1].cells[0] != "tags" || rows[1].cells[3] != "no" {
+		t.Errorf("extractTableRows()[1] = %+v, want tags/no", rows[1].cells)
+	}
+}
+
+func TestParseHCLBlocksTracksDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "variables.tf")
+	content := `
+variable "name" {
+  type        = string
+  description = "the name"
+}
+
+variable "tags" {
+  type        = map(string)
+  description = "resource tags"
+  default     = {}
+}
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	blocks, err := parseHCLBlocks(path, "variable")
+	if err != nil {
+		t.Fatalf("parseHCLBlocks() error: %v", err)
+	}
+
+	if blocks["name"].HasDefault {
+		t.Errorf("variable %q: HasDefault = true, want false", "name")
+	}
+	if !blocks["tags"].HasDefault {
+		t.Errorf("variable %q: HasDefault = false, want true", "tags")
+	}
+}
+
+func TestFindInputsTableIssuesCatchesDrift(t *testing.T) {
+	readmeContents := `## Inputs
+
+| Name | Description | Type | Required |
+|------|--------------|------|----------|
+| ` + "`name`" + ` | the name | string | no |
+`
+	// The README marks `name` as not Required, but it has no default, so the
+	// Required column disagrees with the code.
+	variables := map[string]hclBlock{"name": {Name: "name", HasDefault: false}}
+
+	issues := findInputsTableIssues(readmeContents, variables)
+	if len(issues) != 1 {
+		t.Fatalf("findInputsTableIssues() returned %d issues, want 1 for the Required column mismatch", len(issues))
+	}
+}
+
+func TestFindOutputsTableIssuesCatchesDrift(t *testing.T) {
+	readmeContents := `## Outputs
+
+| Name | Description |
+|------|--------------|
+| ` + "`name`" + ` | the name |
+`
+	// outputs.tf declares nothing, so the documented output has no backing
+	// HCL block.
+	issues := findOutputsTableIssues(readmeContents, map[string]hclBlock{})
+	if len(issues) != 1 {
+		t.Fatalf("findOutputsTableIssues() returned %d issues, want 1 for the undeclared output", len(issues))
+	}
+}
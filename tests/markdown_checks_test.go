@@ -0,0 +1,63 @@
+package main
+
+import "testing"
+
+func TestFindDuplicateLinksTable(t *testing.T) {
+	contents := `## Resources
+
+| Name | Type |
+|------|------|
+| [null_resource.a](https://registry.terraform.io/providers/hashicorp/null/latest/docs/resources/resource) | resource |
+| [null_resource.a](https://registry.terraform.io/providers/hashicorp/null/latest/docs/resources/resource) | resource |
+`
+	issues := findDuplicateLinks(contents)
+	if len(issues) != 1 {
+		t.Fatalf("findDuplicateLinks() returned %d issues, want 1 for a link repeated across table rows", len(issues))
+	}
+}
+
+func TestFindDuplicateLinksBulletStillWorks(t *testing.T) {
+	contents := `## Goals
+
+* [a](https://example.com/a)
+* [b](https://example.com/b)
+`
+	issues := findDuplicateLinks(contents)
+	if len(issues) != 0 {
+		t.Fatalf("findDuplicateLinks() returned %d issues, want 0 when no link repeats", len(issues))
+	}
+}
+
+func TestFindListOrderingIssuesTable(t *testing.T) {
+	contents := `## Resources
+
+| Name | Type |
+|------|------|
+| [zeta](https://example.com/zeta) | resource |
+| [alpha](https://example.com/alpha) | resource |
+`
+	issues := findListOrderingIssues(contents)
+	if len(issues) != 1 {
+		t.Fatalf("findListOrderingIssues() returned %d issues, want 1 for an out-of-order Resources table", len(issues))
+	}
+}
+
+func TestTableListItemsStripsLinkText(t *testing.T) {
+	contents := `## Resources
+
+| Name | Type |
+|------|------|
+| [alpha](https://example.com/alpha) | resource |
+| plain_name | resource |
+`
+	items := tableListItems(contents, "Resources")
+	if len(items) != 2 {
+		t.Fatalf("tableListItems() returned %d items, want 2", len(items))
+	}
+	if items[0].text != "alpha" {
+		t.Errorf("tableListItems()[0].text = %q, want %q", items[0].text, "alpha")
+	}
+	if items[1].text != "plain_name" {
+		t.Errorf("tableListItems()[1].text = %q, want %q", items[1].text, "plain_name")
+	}
+}
@@ -0,0 +1,204 @@
+// Package registry validates links into the Terraform Registry against
+// the registry's own v1 API, rather than sniffing the rendered page for
+// known error markers.
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const baseURL = "https://registry.terraform.io/v1/providers"
+
+// validationError distinguishes transient failures (network errors, 5xx,
+// 429) that a caller should retry from definitive ones (provider/version
+// not found, resource docs missing) that won't change on retry.
+type validationError struct {
+	err       error
+	retryable bool
+}
+
+func (e *validationError) Error() string { return e.err.Error() }
+func (e *validationError) Unwrap() error { return e.err }
+
+// IsRetryable reports whether err represents a transient failure worth
+// retrying (a network error or a 5xx/429 from the Registry API), as
+// opposed to a definitive mismatch between the docs and the pinned
+// provider/resource.
+func IsRetryable(err error) bool {
+	var verr *validationError
+	return errors.As(err, &verr) && verr.retryable
+}
+
+var docURLPattern = regexp.MustCompile(`registry\.terraform\.io/providers/([^/]+)/([^/]+)/([^/]+)/docs/(resources|data-sources)/([^/#?]+)`)
+
+// IsProviderDocsURL reports whether link matches the full Registry provider
+// docs URL shape (".../<version>/docs/(resources|data-sources)/<slug>") that
+// Validate understands. Other registry.terraform.io links, such as a bare
+// provider overview page, don't match and should be checked as plain URLs
+// instead of routed through Validate.
+func IsProviderDocsURL(link string) bool {
+	return docURLPattern.MatchString(link)
+}
+
+// Client validates provider/resource references against the Terraform
+// Registry v1 API and caches results per URL for the lifetime of a test run.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	cache      sync.Map // map[string]error, nil value on success
+}
+
+// NewClient returns a Client with a conservative default timeout.
+func NewClient() *Client {
+	return newClient(baseURL)
+}
+
+func newClient(base string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		baseURL:    base,
+	}
+}
+
+// Validate confirms that rawURL points at a published provider version and
+// that the referenced resource or data source is present in that version's
+// docs. It returns false with a descriptive error such as "provider not
+// found", "version not published" or "resource docs missing" when the
+// reference can't be confirmed. URLs that don't match the Registry docs
+// URL shape are rejected as not applicable to this checker.
+func (c *Client) Validate(rawURL string) (bool, error) {
+	if cached, ok := c.cache.Load(rawURL); ok {
+		if cached == nil {
+			return true, nil
+		}
+		return false, cached.(error)
+	}
+
+	err := c.validate(rawURL)
+	// Retryable (transient) failures aren't cached, so a caller retrying
+	// after a network blip or a 5xx actually hits the API again instead of
+	// replaying the same failure from cache.
+	if err == nil || !IsRetryable(err) {
+		c.cache.Store(rawURL, err)
+	}
+	return err == nil, err
+}
+
+func (c *Client) validate(rawURL string) error {
+	namespace, name, version, kind, slug, ok := parseDocURL(rawURL)
+	if !ok {
+		return fmt.Errorf("not a provider docs URL: %s", rawURL)
+	}
+
+	resolvedVersion, err := c.checkVersion(namespace, name, version)
+	if err != nil {
+		return err
+	}
+
+	return c.checkDocsSlug(namespace, name, resolvedVersion, kind, slug)
+}
+
+func parseDocURL(rawURL string) (namespace, name, version, kind, slug string, ok bool) {
+	matches := docURLPattern.FindStringSubmatch(rawURL)
+	if matches == nil {
+		return "", "", "", "", "", false
+	}
+	return matches[1], matches[2], matches[3], matches[4], matches[5], true
+}
+
+type versionsResponse struct {
+	Versions []struct {
+		Version string `json:"version"`
+	} `json:"versions"`
+}
+
+// checkVersion confirms that version is published for namespace/name and
+// returns the concrete version to check docs against. The Registry has no
+// literal "latest" version, but READMEs conventionally link docs at
+// ".../latest/docs/..." (see the Resources table convention), so "latest" is
+// resolved to the last entry in the /versions response instead of being
+// matched literally. The entries are not sorted by version here, so that is
+// the newest version only when the response lists versions oldest first.
+func (c *Client) checkVersion(namespace, name, version string) (string, error) {
+	url := fmt.Sprintf("%s/%s/%s/versions", c.baseURL, namespace, name)
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return "", &validationError{err: fmt.Errorf("provider not found: %s/%s: %w", namespace, name, err), retryable: true}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", &validationError{err: fmt.Errorf("provider not found: %s/%s", namespace, name)}
+	}
+	if isRetryableStatus(resp.StatusCode) {
+		return "", &validationError{err: fmt.Errorf("provider not found: %s/%s (status %d)", namespace, name, resp.StatusCode), retryable: true}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", &validationError{err: fmt.Errorf("provider not found: %s/%s (status %d)", namespace, name, resp.StatusCode)}
+	}
+
+	var data versionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return "", &validationError{err: fmt.Errorf("provider not found: decoding versions for %s/%s: %w", namespace, name, err)}
+	}
+	if len(data.Versions) == 0 {
+		return "", &validationError{err: fmt.Errorf("version not published: %s/%s has no published versions", namespace, name)}
+	}
+
+	if version == "latest" {
+		return data.Versions[len(data.Versions)-1].Version, nil
+	}
+
+	for _, v := range data.Versions {
+		if v.Version == version {
+			return version, nil
+		}
+	}
+	return "", &validationError{err: fmt.Errorf("version not published: %s/%s@%s", namespace, name, version)}
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+type docsIndexResponse struct {
+	Docs []struct {
+		Slug     string `json:"slug"`
+		Category string `json:"category"`
+	} `json:"docs"`
+}
+
+func (c *Client) checkDocsSlug(namespace, name, version, kind, slug string) error {
+	url := fmt.Sprintf("%s/%s/%s/%s/docs", c.baseURL, namespace, name, version)
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return &validationError{err: fmt.Errorf("resource docs missing: %s/%s/%s/docs/%s/%s: %w", namespace, name, version, kind, slug, err), retryable: true}
+	}
+	defer resp.Body.Close()
+
+	if isRetryableStatus(resp.StatusCode) {
+		return &validationError{err: fmt.Errorf("resource docs missing: %s/%s/%s/docs/%s/%s (status %d)", namespace, name, version, kind, slug, resp.StatusCode), retryable: true}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &validationError{err: fmt.Errorf("resource docs missing: %s/%s/%s/docs/%s/%s (status %d)", namespace, name, version, kind, slug, resp.StatusCode)}
+	}
+
+	var data docsIndexResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return &validationError{err: fmt.Errorf("resource docs missing: decoding docs index for %s/%s@%s: %w", namespace, name, version, err)}
+	}
+
+	for _, doc := range data.Docs {
+		if strings.EqualFold(doc.Category, kind) && strings.EqualFold(doc.Slug, slug) {
+			return nil
+		}
+	}
+	return &validationError{err: fmt.Errorf("resource docs missing: %s/%s/%s/docs/%s/%s", namespace, name, version, kind, slug)}
+}
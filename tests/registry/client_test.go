@@ -0,0 +1,77 @@
+package registry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hashicorp/null/versions", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(versionsResponse{
+			Versions: []struct {
+				Version string `json:"version"`
+			}{{Version: "3.1.0"}, {Version: "3.2.0"}},
+		})
+	})
+	mux.HandleFunc("/hashicorp/null/3.2.0/docs", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(docsIndexResponse{
+			Docs: []struct {
+				Slug     string `json:"slug"`
+				Category string `json:"category"`
+			}{{Slug: "resource", Category: "resources"}},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// The rawURL passed to Validate only needs to match docURLPattern (which
+// hardcodes the registry.terraform.io host); the actual HTTP calls are made
+// against client.baseURL, pointed at the local test server below.
+const fakeDocURL = "https://registry.terraform.io/providers/hashicorp/null/latest/docs/resources/resource"
+const fakeUnpublishedDocURL = "https://registry.terraform.io/providers/hashicorp/null/9.9.9/docs/resources/resource"
+
+func TestValidateResolvesLatestToNewestVersion(t *testing.T) {
+	server := newTestServer(t)
+	client := newClient(server.URL)
+
+	ok, err := client.Validate(fakeDocURL)
+	if !ok || err != nil {
+		t.Fatalf("Validate() = (%v, %v), want (true, nil)", ok, err)
+	}
+}
+
+func TestValidateRejectsUnpublishedVersion(t *testing.T) {
+	server := newTestServer(t)
+	client := newClient(server.URL)
+
+	ok, err := client.Validate(fakeUnpublishedDocURL)
+	if ok || err == nil {
+		t.Fatalf("Validate() = (%v, %v), want (false, non-nil)", ok, err)
+	}
+}
+
+func TestIsProviderDocsURL(t *testing.T) {
+	cases := []struct {
+		url  string
+		want bool
+	}{
+		{"https://registry.terraform.io/providers/hashicorp/null/latest/docs/resources/resource", true},
+		{"https://registry.terraform.io/providers/hashicorp/null/3.2.0/docs/data-sources/data_source", true},
+		{"https://registry.terraform.io/providers/hashicorp/null", false},
+		{"https://registry.terraform.io/providers/hashicorp/null/latest", false},
+	}
+
+	for _, c := range cases {
+		if got := IsProviderDocsURL(c.url); got != c.want {
+			t.Errorf("IsProviderDocsURL(%q) = %v, want %v", c.url, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLineCol(t *testing.T) {
+	contents := "first\nsecond\nthird"
+
+	cases := []struct {
+		offset   int
+		wantLine int
+		wantCol  int
+	}{
+		{0, 1, 1},
+		{5, 1, 6},  // end of "first", before the newline
+		{6, 2, 1},  // start of "second"
+		{13, 3, 1}, // start of "third"
+	}
+
+	for _, c := range cases {
+		line, col := lineCol(contents, c.offset)
+		if line != c.wantLine || col != c.wantCol {
+			t.Errorf("lineCol(_, %d) = (%d, %d), want (%d, %d)", c.offset, line, col, c.wantLine, c.wantCol)
+		}
+	}
+
+	if line, col := lineCol(contents, -1); line != 0 || col != 0 {
+		t.Errorf("lineCol(_, -1) = (%d, %d), want (0, 0)", line, col)
+	}
+}
+
+func TestReporterWriteJUnit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.xml")
+	r := &Reporter{format: "junit", path: path}
+	r.add("README.md", 3, 5, "something is wrong")
+
+	if err := r.Flush(); err != nil {
+		t.Fatalf("Flush() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected report file at %s: %v", path, err)
+	}
+
+	var suite struct {
+		XMLName  xml.Name `xml:"testsuite"`
+		Failures int      `xml:"failures,attr"`
+	}
+	if err := xml.Unmarshal(data, &suite); err != nil {
+		t.Fatalf("report is not valid JUnit XML: %v", err)
+	}
+	if suite.Failures != 1 {
+		t.Errorf("suite.Failures = %d, want 1", suite.Failures)
+	}
+}
+
+func TestReporterWriteSARIF(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.sarif")
+	r := &Reporter{format: "sarif", path: path}
+	r.add("README.md", 3, 5, "something is wrong")
+
+	if err := r.Flush(); err != nil {
+		t.Fatalf("Flush() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected report file at %s: %v", path, err)
+	}
+
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				Message struct {
+					Text string `json:"text"`
+				} `json:"message"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("report is not valid SARIF JSON: %v", err)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 1 {
+		t.Fatalf("SARIF log = %+v, want exactly one run with one result", log)
+	}
+	if log.Runs[0].Results[0].Message.Text != "something is wrong" {
+		t.Errorf("SARIF result message = %q, want %q", log.Runs[0].Results[0].Message.Text, "something is wrong")
+	}
+}
+
+func TestReporterFlushNoPathIsNoop(t *testing.T) {
+	r := &Reporter{format: "junit"}
+	if err := r.Flush(); err != nil {
+		t.Fatalf("Flush() with no REPORT_PATH should be a no-op, got error: %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// finding is a single validation failure, located by file and, where
+// available, a 1-based line:column position within it.
+type finding struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// Reporter collects findings from the markdown test functions and, on
+// Flush, writes them to $REPORT_PATH as JUnit XML (default) or SARIF
+// (REPORT_FORMAT=sarif) so CI can surface them as a test report.
+type Reporter struct {
+	mu       sync.Mutex
+	findings []finding
+	format   string
+	path     string
+}
+
+// NewReporterFromEnv builds a Reporter from REPORT_FORMAT and REPORT_PATH.
+// With REPORT_PATH unset, Flush is a no-op.
+func NewReporterFromEnv() *Reporter {
+	format := os.Getenv("REPORT_FORMAT")
+	if format == "" {
+		format = "junit"
+	}
+	return &Reporter{
+		format: format,
+		path:   os.Getenv("REPORT_PATH"),
+	}
+}
+
+var globalReporter = NewReporterFromEnv()
+
+// TestMain flushes the accumulated report once all markdown tests have run.
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if err := globalReporter.Flush(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write report to %s: %v\n", os.Getenv("REPORT_PATH"), err)
+	}
+	os.Exit(code)
+}
+
+// report fails the test via t.Errorf, and additionally records the failure
+// against file at the given byte offset within contents (offset < 0 when no
+// specific location applies) as a GitHub Actions inline annotation and as a
+// finding for the report written by TestMain.
+func report(t *testing.T, file, contents string, offset int, format string, args ...interface{}) {
+	t.Helper()
+
+	message := fmt.Sprintf(format, args...)
+	t.Errorf("Failed: %s", message)
+
+	line, col := 0, 0
+	if offset >= 0 {
+		line, col = lineCol(contents, offset)
+	}
+	globalReporter.add(file, line, col, message)
+}
+
+func (r *Reporter) add(file string, line, col int, message string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.findings = append(r.findings, finding{File: file, Line: line, Column: col, Message: message})
+
+	if line > 0 {
+		fmt.Printf("::error file=%s,line=%d,col=%d::%s\n", file, line, col, message)
+	} else {
+		fmt.Printf("::error file=%s::%s\n", file, message)
+	}
+}
+
+// lineCol translates a byte offset into contents to a 1-based line:column
+// pair by counting newlines in the prefix up to offset.
+func lineCol(contents string, offset int) (line, col int) {
+	if offset < 0 || offset > len(contents) {
+		return 0, 0
+	}
+
+	prefix := contents[:offset]
+	line = strings.Count(prefix, "\n") + 1
+	if idx := strings.LastIndex(prefix, "\n"); idx >= 0 {
+		col = offset - idx
+	} else {
+		col = offset + 1
+	}
+	return line, col
+}
+
+// Flush writes the collected findings to r.path in r.format. It is a no-op
+// when no REPORT_PATH was configured.
+func (r *Reporter) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.path == "" {
+		return nil
+	}
+
+	if r.format == "sarif" {
+		return r.writeSARIF()
+	}
+	return r.writeJUnit()
+}
+
+func (r *Reporter) writeJUnit() error {
+	type jUnitFailure struct {
+		Message string `xml:",chardata"`
+	}
+	type jUnitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		Classname string        `xml:"classname,attr"`
+		Failure   *jUnitFailure `xml:"failure,omitempty"`
+	}
+	type jUnitTestSuite struct {
+		XMLName   xml.Name        `xml:"testsuite"`
+		Name      string          `xml:"name,attr"`
+		Tests     int             `xml:"tests,attr"`
+		Failures  int             `xml:"failures,attr"`
+		TestCases []jUnitTestCase `xml:"testcase"`
+	}
+
+	suite := jUnitTestSuite{
+		Name:     "TestMarkdown",
+		Tests:    len(r.findings),
+		Failures: len(r.findings),
+	}
+	for i, f := range r.findings {
+		suite.TestCases = append(suite.TestCases, jUnitTestCase{
+			Name:      fmt.Sprintf("%s#%d", f.File, i),
+			Classname: f.File,
+			Failure:   &jUnitFailure{Message: fmt.Sprintf("%s:%d:%d: %s", f.File, f.Line, f.Column, f.Message)},
+		})
+	}
+
+	out, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append([]byte(xml.Header), out...), 0o644)
+}
+
+func (r *Reporter) writeSARIF() error {
+	type sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+	type sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+	}
+	type sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           sarifRegion           `json:"region"`
+	}
+	type sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+	type sarifMessage struct {
+		Text string `json:"text"`
+	}
+	type sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations"`
+	}
+	type sarifDriver struct {
+		Name string `json:"name"`
+	}
+	type sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+	type sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+	type sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	run := sarifRun{Tool: sarifTool{Driver: sarifDriver{Name: "TestMarkdown"}}}
+	for _, f := range r.findings {
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  "markdown-validation",
+			Message: sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: f.File},
+					Region: sarifRegion{
+						StartLine:   maxInt(f.Line, 1),
+						StartColumn: maxInt(f.Column, 1),
+					},
+				},
+			}},
+		})
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+
+	out, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, out, 0o644)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/CloudNationHQ/az-cn-shared-tf-workflows/tests/registry"
+)
+
+const (
+	defaultURLCheckWorkers = 8
+	defaultURLCheckTimeout = 10 * time.Second
+	defaultURLCheckRetries = 2
+	defaultURLCheckRPS     = 5
+)
+
+// CheckResult is the outcome of checking a single URL.
+type CheckResult struct {
+	URL        string
+	StatusCode int
+	Err        error
+}
+
+// URLChecker validates a batch of URLs through a bounded worker pool,
+// applying a per-host rate limit and retrying transient failures with
+// exponential backoff.
+type URLChecker struct {
+	client   *http.Client
+	workers  int
+	retries  int
+	rps      float64
+	registry *registry.Client
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// sharedRegistryClient is used by every URLChecker, so its per-URL cache
+// lasts for the whole test run rather than for a single README.
+var sharedRegistryClient = registry.NewClient()
+
+// NewURLCheckerFromEnv builds a URLChecker from URL_CHECK_WORKERS,
+// URL_CHECK_TIMEOUT (seconds) and URL_CHECK_RETRIES, falling back to
+// sane defaults so CI can tune behavior without code changes.
+func NewURLCheckerFromEnv() *URLChecker {
+	return &URLChecker{
+		client:   &http.Client{Timeout: envDuration("URL_CHECK_TIMEOUT", defaultURLCheckTimeout)},
+		workers:  envInt("URL_CHECK_WORKERS", defaultURLCheckWorkers),
+		retries:  envInt("URL_CHECK_RETRIES", defaultURLCheckRetries),
+		rps:      defaultURLCheckRPS,
+		registry: sharedRegistryClient,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Check validates each URL and returns one CheckResult per input, in order.
+func (c *URLChecker) Check(urls []string) []CheckResult {
+	results := make([]CheckResult, len(urls))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	workers := c.workers
+	if workers > len(urls) {
+		workers = len(urls)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = c.checkOne(urls[i])
+			}
+		}()
+	}
+
+	for i := range urls {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func (c *URLChecker) checkOne(link string) CheckResult {
+	isRegistryDocs := registry.IsProviderDocsURL(link)
+	limiter := c.limiterFor(link)
+
+	var lastErr error
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff(attempt))
+		}
+		if limiter != nil {
+			_ = limiter.Wait(context.Background())
+		}
+
+		if isRegistryDocs {
+			if _, err := c.registry.Validate(link); err != nil {
+				lastErr = err
+				if !registry.IsRetryable(err) {
+					break
+				}
+				continue
+			}
+			return CheckResult{URL: link, StatusCode: http.StatusOK}
+		}
+
+		status, err := c.attempt(link)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if isSuccessStatus(status) {
+			return CheckResult{URL: link, StatusCode: status}
+		}
+
+		lastErr = fmt.Errorf("unexpected status code: %d", status)
+		if !isRetryableStatus(status) {
+			break
+		}
+	}
+
+	return CheckResult{URL: link, Err: lastErr}
+}
+
+// attempt issues a HEAD request and falls back to GET when the server
+// doesn't support HEAD, which is common for registry and docs sites.
+func (c *URLChecker) attempt(link string) (int, error) {
+	req, err := http.NewRequest(http.MethodHead, link, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err == nil {
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusMethodNotAllowed && resp.StatusCode != http.StatusNotImplemented {
+			return resp.StatusCode, nil
+		}
+	}
+
+	resp, err = c.client.Get(link)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+func (c *URLChecker) limiterFor(link string) *rate.Limiter {
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Host == "" {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	limiter, ok := c.limiters[parsed.Host]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(c.rps), 1)
+		c.limiters[parsed.Host] = limiter
+	}
+	return limiter
+}
+
+func isSuccessStatus(status int) bool {
+	return status >= 200 && status < 400
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+func backoff(attempt int) time.Duration {
+	return time.Duration(1<<uint(attempt)) * 250 * time.Millisecond
+}
+
+func envInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return fallback
+	}
+	return n
+}
+
+func envDuration(key string, fallbackSeconds time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallbackSeconds
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return fallbackSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}